@@ -0,0 +1,201 @@
+package graphql
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/handler"
+	"github.com/jinzhu/inflection"
+)
+
+//Middleware wraps a field resolver, letting callers layer cross-cutting
+//concerns (auth, logging, tracing, ...) onto every generated field
+type Middleware func(graphql.FieldResolveFn) graphql.FieldResolveFn
+
+//Builder composes entities, ad-hoc fields and middleware into a graphql.Schema,
+//hiding the FieldType/RelationshipType/MutationType/FilterType reflection plumbing
+type Builder struct {
+	entities        []Entity
+	entitiesMap     map[string]Entity
+	entitiesObjects map[string]*graphql.Object
+	entitiesFilters map[string]*graphql.InputObject
+	resolvers       map[string]Resolvers
+
+	queryFields    graphql.Fields
+	mutationFields graphql.Fields
+	middleware     []Middleware
+	registry       *TypeRegistry
+}
+
+//NewBuilder creates an empty Builder ready for RegisterEntity/RegisterQueryField calls
+func NewBuilder() *Builder {
+	return &Builder{
+		entitiesMap:     make(map[string]Entity),
+		entitiesObjects: make(map[string]*graphql.Object),
+		entitiesFilters: make(map[string]*graphql.InputObject),
+		resolvers:       make(map[string]Resolvers),
+		queryFields:     make(graphql.Fields),
+		mutationFields:  make(graphql.Fields),
+		registry:        NewTypeRegistry(),
+	}
+}
+
+//RegisterScalar registers a custom scalar, available to entity fields tagged `graphql:"type=name"`
+func (b *Builder) RegisterScalar(name string, serialize graphql.SerializeFn, parseValue graphql.ParseValueFn, parseLiteral graphql.ParseLiteralFn) *Builder {
+	b.registry.RegisterScalar(name, serialize, parseValue, parseLiteral)
+	return b
+}
+
+//RegisterEnum registers an enum, available to entity fields tagged `graphql:"enum=name"`
+func (b *Builder) RegisterEnum(name string, values ...string) *Builder {
+	b.registry.RegisterEnum(name, values...)
+	return b
+}
+
+//RegisterInterface registers a GraphQL interface, used to resolve polymorphic entity fields
+func (b *Builder) RegisterInterface(name string, resolveType graphql.ResolveTypeFn) *Builder {
+	b.registry.RegisterInterface(name, resolveType)
+	return b
+}
+
+//RegisterEntity adds an entity to the schema, generating its object type,
+//listing/single query fields and create/update/delete mutation fields.
+//Registering the same entity name twice is a no-op past the first call.
+func (b *Builder) RegisterEntity(entity Entity, resolvers Resolvers) *Builder {
+	name := entityName(entity)
+	if _, ok := b.resolvers[name]; ok {
+		return b
+	}
+
+	b.entities = append(b.entities, entity)
+	b.resolvers[name] = resolvers
+	return b
+}
+
+//RegisterQueryField attaches an additional, hand-built field to the root Query object
+func (b *Builder) RegisterQueryField(name string, field *graphql.Field) *Builder {
+	b.queryFields[name] = field
+	return b
+}
+
+//RegisterMutationField attaches an additional, hand-built field to the root Mutation object
+func (b *Builder) RegisterMutationField(name string, field *graphql.Field) *Builder {
+	b.mutationFields[name] = field
+	return b
+}
+
+//Use appends a middleware to be applied to every generated field resolver, in registration order
+func (b *Builder) Use(m Middleware) *Builder {
+	b.middleware = append(b.middleware, m)
+	return b
+}
+
+//BuildSchema generates the object/filter/input types for every registered entity,
+//wires them into the root Query and Mutation objects and assembles the schema
+func (b *Builder) BuildSchema() (graphql.Schema, error) {
+	for _, entity := range b.entities {
+		name := entityName(entity)
+		if _, ok := b.entitiesObjects[name]; ok {
+			continue //already registered
+		}
+
+		object, filters, err := FieldType(entity, b.registry)
+		if err != nil {
+			return graphql.Schema{}, fmt.Errorf("builder: building type for %s: %w", name, err)
+		}
+
+		b.entitiesMap[name] = entity
+		b.entitiesObjects[name] = object
+		if len(filters) > 0 {
+			b.entitiesFilters[name] = FilterType(name, filters)
+		}
+	}
+
+	for _, entity := range b.entities {
+		name := entityName(entity)
+		resolvers := b.resolvers[name]
+
+		relationships, err := RelationshipType(b.entitiesMap, b.entitiesObjects, b.entitiesFilters, entity, resolvers)
+		if err != nil {
+			return graphql.Schema{}, fmt.Errorf("builder: building relationships for %s: %w", name, err)
+		}
+		for fname, f := range relationships {
+			f.Resolve = applyMiddleware(f.Resolve, b.middleware)
+			b.entitiesObjects[name].AddFieldConfig(fname, f)
+		}
+
+		listing := &graphql.Field{
+			Name:        inflectionPlural(name),
+			Type:        graphql.NewList(b.entitiesObjects[name]),
+			Description: fmt.Sprintf("Get a list of %s (%s) according to filters", name, entity.Description()),
+			Resolve:     resolvers.Listing(entity),
+		}
+		if filter, ok := b.entitiesFilters[name]; ok {
+			listing.Args = graphql.FieldConfigArgument{"filter": &graphql.ArgumentConfig{Type: filter}}
+			listing.Resolve = withParsedFilter(resolvers.ListingWithFilter(entity))
+		}
+		b.queryFields[inflectionPlural(name)] = listing
+		b.queryFields[name] = &graphql.Field{
+			Name:        name,
+			Type:        b.entitiesObjects[name],
+			Description: fmt.Sprintf("Get a single %s (%s) by id or slug", name, entity.Description()),
+			Resolve:     resolvers.Single(entity),
+		}
+
+		mutations, err := MutationType(entity, resolvers, b.registry)
+		if err != nil {
+			return graphql.Schema{}, fmt.Errorf("builder: building mutations for %s: %w", name, err)
+		}
+		for fname, f := range mutations {
+			b.mutationFields[fname] = f
+		}
+	}
+
+	for _, field := range b.queryFields {
+		field.Resolve = applyMiddleware(field.Resolve, b.middleware)
+	}
+	for _, field := range b.mutationFields {
+		field.Resolve = applyMiddleware(field.Resolve, b.middleware)
+	}
+
+	query := graphql.NewObject(graphql.ObjectConfig{Name: "Query", Fields: b.queryFields})
+
+	schemaConfig := graphql.SchemaConfig{Query: query}
+	if len(b.mutationFields) > 0 {
+		schemaConfig.Mutation = graphql.NewObject(graphql.ObjectConfig{Name: "Mutation", Fields: b.mutationFields})
+	}
+
+	return graphql.NewSchema(schemaConfig)
+}
+
+//NewHandler builds an http.Handler serving the schema, exposing the bundled
+//GraphiQL UI at the same route when graphiql is true and Playground otherwise
+func (b *Builder) NewHandler(schema *graphql.Schema, graphiql bool) http.Handler {
+	return handler.New(&handler.Config{
+		Schema:     schema,
+		Pretty:     true,
+		GraphiQL:   graphiql,
+		Playground: !graphiql,
+	})
+}
+
+func applyMiddleware(resolve graphql.FieldResolveFn, middleware []Middleware) graphql.FieldResolveFn {
+	if resolve == nil {
+		return nil
+	}
+	for i := len(middleware) - 1; i >= 0; i-- {
+		resolve = middleware[i](resolve)
+	}
+	return resolve
+}
+
+func entityName(entity Entity) string {
+	return strings.ToLower(reflect.TypeOf(entity.Instance()).Name())
+}
+
+func inflectionPlural(name string) string {
+	return strings.ToLower(inflection.Plural(name))
+}
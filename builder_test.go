@@ -0,0 +1,39 @@
+package graphql
+
+import "testing"
+
+func TestBuilderBuildSchemaWithReadOnlyEntity(t *testing.T) {
+	builder := NewBuilder()
+	builder.RegisterEntity(newFixtureEntity(fixtureAuthor{}, "an author"), noopResolvers{})
+	builder.RegisterEntity(newFixtureEntity(fixtureReadOnly{}, "a read-only entity"), noopResolvers{})
+
+	if _, err := builder.BuildSchema(); err != nil {
+		t.Fatalf("BuildSchema returned error for a schema mixing mutable and read-only entities: %v", err)
+	}
+}
+
+//taggedResolvers lets a test tell which registration actually won
+type taggedResolvers struct {
+	noopResolvers
+	tag string
+}
+
+func TestRegisterEntityIsNoopPastFirstCall(t *testing.T) {
+	builder := NewBuilder()
+	entity := newFixtureEntity(fixtureAuthor{}, "an author")
+
+	builder.RegisterEntity(entity, taggedResolvers{tag: "first"})
+	builder.RegisterEntity(entity, taggedResolvers{tag: "second"})
+
+	if len(builder.entities) != 1 {
+		t.Fatalf("expected registering the same entity twice to add it once, got %d entries", len(builder.entities))
+	}
+
+	resolvers, ok := builder.resolvers[entityName(entity)].(taggedResolvers)
+	if !ok {
+		t.Fatalf("expected a taggedResolvers to be stored")
+	}
+	if resolvers.tag != "first" {
+		t.Fatalf("expected the first registration to win, got %q", resolvers.tag)
+	}
+}
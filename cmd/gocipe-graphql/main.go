@@ -0,0 +1,46 @@
+//Command gocipe-graphql drives schema-first codegen for the graphql package
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/gocipe/graphql/codegen"
+)
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(1)
+	}
+
+	switch os.Args[1] {
+	case "generate":
+		runGenerate(os.Args[2:])
+	default:
+		usage()
+		os.Exit(1)
+	}
+}
+
+func runGenerate(args []string) {
+	fs := flag.NewFlagSet("generate", flag.ExitOnError)
+	configPath := fs.String("config", "gqlgen.yml", "path to the codegen config file")
+	fs.Parse(args)
+
+	cfg, err := codegen.LoadConfig(*configPath)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	if err := codegen.Generate(cfg); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: gocipe-graphql generate --config gqlgen.yml")
+}
@@ -0,0 +1,68 @@
+package codegen
+
+import (
+	"fmt"
+	"io/ioutil"
+
+	yaml "gopkg.in/yaml.v2"
+)
+
+//Config describes a codegen run, loaded from a `gqlgen.yml`-style file. The
+//SDL schema drives the shape of the generated resolver/input/enum stubs;
+//registration itself still goes through the reflection-based
+//FieldType/RelationshipType/MutationType pipeline once Register{{Name}} calls
+//builder.RegisterEntity, the same as hand-written entities do.
+type Config struct {
+	//SchemaFile is the path to the `.graphql` SDL file describing the API
+	SchemaFile string `yaml:"schema"`
+
+	//Exec configures where the generated resolver/input/enum bindings are written
+	Exec struct {
+		Filename string `yaml:"filename"`
+		Package  string `yaml:"package"`
+	} `yaml:"exec"`
+
+	//Entities maps a schema type name to the Go Entity implementation that
+	//should back it
+	Entities map[string]EntityConfig `yaml:"entities"`
+}
+
+//EntityConfig locates the Go Entity implementation backing an SDL object type,
+//so the generated file can both import its package and reference its type
+type EntityConfig struct {
+	//Package is the import path of the package declaring Type
+	Package string `yaml:"package"`
+	//Type is the Entity's type name within Package
+	Type string `yaml:"type"`
+}
+
+//LoadConfig reads and validates a codegen config file
+func LoadConfig(path string) (*Config, error) {
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("codegen: reading config: %w", err)
+	}
+
+	cfg := &Config{}
+	if err := yaml.Unmarshal(raw, cfg); err != nil {
+		return nil, fmt.Errorf("codegen: parsing config: %w", err)
+	}
+
+	if cfg.SchemaFile == "" {
+		return nil, fmt.Errorf("codegen: config is missing required field %q", "schema")
+	}
+	if cfg.Exec.Filename == "" {
+		return nil, fmt.Errorf("codegen: config is missing required field %q", "exec.filename")
+	}
+	if cfg.Exec.Package == "" {
+		cfg.Exec.Package = "generated"
+	}
+
+	for name, entity := range cfg.Entities {
+		if entity.Package == "" || entity.Type == "" {
+			return nil, fmt.Errorf("codegen: entities.%s is missing required field %q or %q", name, "package", "type")
+		}
+	}
+
+	return cfg, nil
+}
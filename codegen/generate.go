@@ -0,0 +1,168 @@
+package codegen
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+	"text/template"
+
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/parser"
+	gqlsource "github.com/graphql-go/graphql/language/source"
+)
+
+//objectType is the subset of an SDL object definition the resolver template needs
+type objectType struct {
+	Name        string
+	EntityType  string //package-qualified Go type name of the entity, e.g. "postentity.Post"
+	ImportPath  string //import path of the package declaring the entity, from Config.Entities
+	ImportAlias string //import alias for ImportPath, derived from Name to avoid collisions
+	Fields      []string
+}
+
+//enumType is the subset of an SDL enum definition the binding template needs
+type enumType struct {
+	Name   string
+	Values []string
+}
+
+//inputType is the subset of an SDL input definition the template needs
+type inputType struct {
+	Name   string
+	Fields []string
+}
+
+type templateData struct {
+	Package string
+	Objects []objectType
+	Enums   []enumType
+	Inputs  []inputType
+}
+
+//Generate parses cfg.SchemaFile and writes typed resolver stubs, input objects
+//and enum bindings for it to cfg.Exec.Filename. Every SDL object type must
+//have a matching entry in cfg.Entities, whose package is imported under an
+//alias derived from the SDL type name so the generated Register{{Name}} can
+//reference the entity directly. The generated resolver interface embeds the
+//existing graphql.Resolvers, and Register{{Name}} calls builder.RegisterEntity
+//exactly as hand-written code would, so the struct-reflection FieldType/
+//RelationshipType/MutationType pipeline still drives the actual schema; SDL
+//fields/inputs/enums only shape the generated Go bindings, they are not
+//reconciled against the entity struct.
+func Generate(cfg *Config) error {
+	raw, err := ioutil.ReadFile(cfg.SchemaFile)
+	if err != nil {
+		return fmt.Errorf("codegen: reading schema: %w", err)
+	}
+
+	doc, err := parser.Parse(parser.ParseParams{
+		Source: gqlsource.NewSource(&gqlsource.Source{Body: raw, Name: cfg.SchemaFile}),
+	})
+	if err != nil {
+		return fmt.Errorf("codegen: parsing schema: %w", err)
+	}
+
+	data := templateData{Package: cfg.Exec.Package}
+
+	for _, def := range doc.Definitions {
+		switch d := def.(type) {
+		case *ast.ObjectDefinition:
+			entity, ok := cfg.Entities[d.Name.Value]
+			if !ok {
+				return fmt.Errorf("codegen: SDL type %q has no matching entry in config.entities", d.Name.Value)
+			}
+			alias := strings.ToLower(d.Name.Value) + "entity"
+			data.Objects = append(data.Objects, objectType{
+				Name:        d.Name.Value,
+				EntityType:  alias + "." + entity.Type,
+				ImportPath:  entity.Package,
+				ImportAlias: alias,
+				Fields:      fieldNames(d.Fields),
+			})
+		case *ast.EnumDefinition:
+			values := make([]string, len(d.Values))
+			for i, v := range d.Values {
+				values[i] = v.Name.Value
+			}
+			data.Enums = append(data.Enums, enumType{Name: d.Name.Value, Values: values})
+		case *ast.InputObjectDefinition:
+			data.Inputs = append(data.Inputs, inputType{
+				Name:   d.Name.Value,
+				Fields: inputFieldNames(d.Fields),
+			})
+		}
+	}
+
+	out, err := os.Create(cfg.Exec.Filename)
+	if err != nil {
+		return fmt.Errorf("codegen: creating output file: %w", err)
+	}
+	defer out.Close()
+
+	if err := resolverTemplate.Execute(out, data); err != nil {
+		return fmt.Errorf("codegen: executing template: %w", err)
+	}
+
+	return nil
+}
+
+func fieldNames(fields []*ast.FieldDefinition) []string {
+	names := make([]string, len(fields))
+	for i, f := range fields {
+		names[i] = f.Name.Value
+	}
+	return names
+}
+
+func inputFieldNames(fields []*ast.InputValueDefinition) []string {
+	names := make([]string, len(fields))
+	for i, f := range fields {
+		names[i] = f.Name.Value
+	}
+	return names
+}
+
+var resolverTemplate = template.Must(template.New("resolvers").Funcs(template.FuncMap{
+	"title": strings.Title,
+}).Parse(`// Code generated by gocipe-graphql codegen. DO NOT EDIT.
+
+package {{.Package}}
+
+{{if .Objects}}import (
+	gocipegraphql "github.com/gocipe/graphql"
+{{range .Objects}}	{{.ImportAlias}} "{{.ImportPath}}"
+{{end}})
+{{end}}
+{{range .Objects}}
+//{{.Name}}Resolver backs the SDL-declared {{.Name}} type, which is registered
+//against {{.EntityType}}. It embeds the existing graphql.Resolvers interface so
+//backends wire up identically to the struct-reflection path.
+type {{.Name}}Resolver interface {
+	gocipegraphql.Resolvers
+}
+
+//Register{{.Name}} registers {{.EntityType}} against builder, backed by resolvers
+func Register{{.Name}}(builder *gocipegraphql.Builder, resolvers {{.Name}}Resolver) {
+	builder.RegisterEntity({{.EntityType}}{}, resolvers)
+}
+{{end}}
+{{range .Inputs}}
+//{{.Name}} is the Go binding for the {{.Name}} input
+type {{.Name}} struct {
+{{- range .Fields}}
+	{{title .}} interface{}
+{{- end}}
+}
+{{end}}
+{{range .Enums}}{{$enum := .}}
+//{{.Name}} is the Go binding for the {{.Name}} enum
+type {{.Name}} string
+
+const (
+{{- range .Values}}
+	{{$enum.Name}}{{.}} {{$enum.Name}} = "{{.}}"
+{{- end}}
+)
+{{end}}
+`))
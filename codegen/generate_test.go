@@ -0,0 +1,77 @@
+package codegen
+
+import (
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestGenerateEmbedsExistingResolversInterface(t *testing.T) {
+	dir := t.TempDir()
+
+	schemaPath := filepath.Join(dir, "schema.graphql")
+	schema := `
+type Post {
+	id: ID!
+	title: String!
+}
+
+enum Status {
+	DRAFT
+	PUBLISHED
+}
+`
+	if err := os.WriteFile(schemaPath, []byte(schema), 0o644); err != nil {
+		t.Fatalf("writing schema fixture: %v", err)
+	}
+
+	cfg := &Config{
+		SchemaFile: schemaPath,
+		Entities:   map[string]EntityConfig{"Post": {Package: "example.com/app/models", Type: "Post"}},
+	}
+	cfg.Exec.Filename = filepath.Join(dir, "generated.go")
+	cfg.Exec.Package = "generated"
+
+	if err := Generate(cfg); err != nil {
+		t.Fatalf("Generate returned error: %v", err)
+	}
+
+	out, err := os.ReadFile(cfg.Exec.Filename)
+	if err != nil {
+		t.Fatalf("reading generated output: %v", err)
+	}
+
+	if !strings.Contains(string(out), "gocipegraphql.Resolvers") {
+		t.Fatalf("expected the generated PostResolver to embed the existing Resolvers interface, got:\n%s", out)
+	}
+	if !strings.Contains(string(out), "postentity.Post{}") {
+		t.Fatalf("expected the generated registration to reference the configured entity, got:\n%s", out)
+	}
+	if !strings.Contains(string(out), `postentity "example.com/app/models"`) {
+		t.Fatalf("expected the generated file to import the entity's own package, got:\n%s", out)
+	}
+
+	if _, err := parser.ParseFile(token.NewFileSet(), cfg.Exec.Filename, nil, parser.AllErrors); err != nil {
+		t.Fatalf("generated file is not valid Go: %v", err)
+	}
+}
+
+func TestGenerateFailsForUnmappedSDLType(t *testing.T) {
+	dir := t.TempDir()
+
+	schemaPath := filepath.Join(dir, "schema.graphql")
+	if err := os.WriteFile(schemaPath, []byte("type Post { id: ID! }"), 0o644); err != nil {
+		t.Fatalf("writing schema fixture: %v", err)
+	}
+
+	cfg := &Config{SchemaFile: schemaPath, Entities: map[string]EntityConfig{}}
+	cfg.Exec.Filename = filepath.Join(dir, "generated.go")
+	cfg.Exec.Package = "generated"
+
+	if err := Generate(cfg); err == nil {
+		t.Fatalf("expected Generate to fail for an SDL type with no entities mapping")
+	}
+}
@@ -0,0 +1,29 @@
+package graphql
+
+import "github.com/graphql-go/graphql"
+
+//Entity describes a domain struct that can be exposed over GraphQL by
+//FieldType, RelationshipType and MutationType
+type Entity interface {
+	//Instance returns a zero value of the struct those functions reflect over
+	Instance() interface{}
+	//Description is surfaced as the generated GraphQL type's description
+	Description() string
+}
+
+//Resolvers supplies the backend behaviour used to satisfy the fields and
+//mutations generated for an Entity
+type Resolvers interface {
+	//Single resolves a struct-typed relationship/query field, fetching one entity by id or slug
+	Single(entity Entity) graphql.FieldResolveFn
+	//Listing resolves a slice-typed relationship/query field with no filter argument
+	Listing(entity Entity) graphql.FieldResolveFn
+	//ListingWithFilter resolves a slice-typed relationship/query field carrying a generated Filter argument
+	ListingWithFilter(entity Entity) graphql.FieldResolveFn
+	//Create resolves the generated `create<Entity>` mutation
+	Create(entity Entity) graphql.FieldResolveFn
+	//Update resolves the generated `update<Entity>` mutation
+	Update(entity Entity) graphql.FieldResolveFn
+	//Delete resolves the generated `delete<Entity>` mutation
+	Delete(entity Entity) graphql.FieldResolveFn
+}
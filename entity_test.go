@@ -0,0 +1,36 @@
+package graphql
+
+import "testing"
+
+func TestFieldTypeBuildsObjectForEntity(t *testing.T) {
+	entity := newFixtureEntity(fixturePost{}, "a blog post")
+
+	object, filters, err := FieldType(entity, nil)
+	if err != nil {
+		t.Fatalf("FieldType returned error: %v", err)
+	}
+	if object.Name() != "fixturepost" {
+		t.Fatalf("expected object name %q, got %q", "fixturepost", object.Name())
+	}
+	if _, ok := filters["title"]; !ok {
+		t.Fatalf("expected %q to be reported as filterable", "title")
+	}
+	if _, ok := object.Fields()["tags"]; !ok {
+		t.Fatalf("expected a %q scalar-list field to be generated", "tags")
+	}
+}
+
+func TestMutationTypeGeneratesCreateUpdateDelete(t *testing.T) {
+	entity := newFixtureEntity(fixturePost{}, "a blog post")
+
+	fields, err := MutationType(entity, noopResolvers{}, nil)
+	if err != nil {
+		t.Fatalf("MutationType returned error: %v", err)
+	}
+
+	for _, name := range []string{"createfixturePost", "updatefixturePost", "deletefixturePost"} {
+		if _, ok := fields[name]; !ok {
+			t.Fatalf("expected mutation field %q to be generated", name)
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package graphql
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/graphql-go/graphql"
+)
+
+type fixtureTagged struct {
+	Status  string      `json:"status" graphql:"enum=Status" filterable:"true"`
+	Tags    []string    `json:"tags" graphql:"enum=Status"`
+	Comment string      `json:"comment" graphql:"type=BigInt"`
+	Owner   interface{} `json:"owner" graphql:"interface=Owner"`
+}
+
+func taggedRegistry() *TypeRegistry {
+	registry := NewTypeRegistry()
+	registry.RegisterEnum("Status", "draft", "published")
+	registry.RegisterScalar("BigInt", nil, nil, nil)
+	registry.RegisterInterface("Owner", func(graphql.ResolveTypeParams) *graphql.Object { return nil })
+	return registry
+}
+
+func TestFieldTagResolvesRegisteredInterface(t *testing.T) {
+	typeof := reflect.TypeOf(fixtureTagged{})
+	registry := taggedRegistry()
+
+	def, err := field(typeof.Field(3), registry) // Owner interface{} `graphql:"interface=Owner"`
+	if err != nil {
+		t.Fatalf("field returned error: %v", err)
+	}
+
+	if _, ok := def.field.Type.(*graphql.Interface); !ok {
+		t.Fatalf("expected the registered interface type, got %T", def.field.Type)
+	}
+}
+
+func TestFieldTagResolvesListForSliceField(t *testing.T) {
+	typeof := reflect.TypeOf(fixtureTagged{})
+	registry := taggedRegistry()
+
+	def, err := field(typeof.Field(1), registry) // Tags []string `graphql:"enum=Status"`
+	if err != nil {
+		t.Fatalf("field returned error: %v", err)
+	}
+
+	if _, ok := def.field.Type.(*graphql.List); !ok {
+		t.Fatalf("expected a list type for a slice field with a graphql tag, got %T", def.field.Type)
+	}
+}
+
+func TestFieldTagHonoursFilterableTag(t *testing.T) {
+	typeof := reflect.TypeOf(fixtureTagged{})
+	registry := taggedRegistry()
+
+	def, err := field(typeof.Field(0), registry) // Status string `graphql:"enum=Status" filterable:"true"`
+	if err != nil {
+		t.Fatalf("field returned error: %v", err)
+	}
+
+	if def.filter != filterTagged {
+		t.Fatalf("expected a tagged, filterable field to report filterTagged, got %d", def.filter)
+	}
+}
@@ -0,0 +1,178 @@
+package graphql
+
+import (
+	"context"
+	"strings"
+
+	"github.com/graphql-go/graphql"
+)
+
+//comparable operators shared by string, int, float and date kinds; bool and
+//tagged kinds expose only _eq and _ne
+var filterOperatorsCommon = []string{"_eq", "_ne", "_in"}
+
+//comparable operators added for orderable kinds (int, float, date)
+var filterOperatorsRange = []string{"_gt", "_gte", "_lt", "_lte"}
+
+//comparable operators added for strings
+var filterOperatorsString = []string{"_contains", "_startsWith"}
+
+//FilterTree is the parsed representation of a generated Filter input. Fields
+//resolved through a Resolvers.ListingWithFilter wired up by Builder or
+//RelationshipType have it parsed from the "filter" argument and stashed in
+//the resolver's context; retrieve it with FilterFromContext rather than
+//re-parsing p.Args["filter"] by hand.
+type FilterTree struct {
+	Field    string
+	Operator string
+	Value    interface{}
+	And      []*FilterTree
+	Or       []*FilterTree
+	Not      *FilterTree
+}
+
+//filterOperatorSuffixes lists every operator suffix a filter field key can end
+//in, longest first so e.g. "_gte" is matched before the shorter "_gt"
+var filterOperatorSuffixes = []string{"_startsWith", "_contains", "_gte", "_lte", "_gt", "_lt", "_ne", "_in", "_eq"}
+
+//ParseFilterTree turns the decoded value of a generated <Entity>Filter argument
+//into a FilterTree, resolving _and/_or/_not composition and splitting every
+//other key into its field name and comparison operator. Sibling keys (e.g.
+//both "title_eq" and "status_eq" in the same object) are implicitly ANDed.
+//Returns nil for an empty or absent filter.
+func ParseFilterTree(args map[string]interface{}) *FilterTree {
+	if len(args) == 0 {
+		return nil
+	}
+
+	tree := &FilterTree{}
+	for key, value := range args {
+		switch key {
+		case "_and":
+			for _, v := range value.([]interface{}) {
+				if sub := ParseFilterTree(v.(map[string]interface{})); sub != nil {
+					tree.And = append(tree.And, sub)
+				}
+			}
+		case "_or":
+			for _, v := range value.([]interface{}) {
+				if sub := ParseFilterTree(v.(map[string]interface{})); sub != nil {
+					tree.Or = append(tree.Or, sub)
+				}
+			}
+		case "_not":
+			tree.Not = ParseFilterTree(value.(map[string]interface{}))
+		default:
+			field, operator := splitFilterKey(key)
+			tree.And = append(tree.And, &FilterTree{Field: field, Operator: operator, Value: value})
+		}
+	}
+
+	return tree
+}
+
+//splitFilterKey splits a generated filter field key (e.g. "title_contains")
+//into its field name and operator suffix
+func splitFilterKey(key string) (field string, operator string) {
+	for _, op := range filterOperatorSuffixes {
+		if strings.HasSuffix(key, op) {
+			return strings.TrimSuffix(key, op), op
+		}
+	}
+	return key, ""
+}
+
+type filterTreeContextKey struct{}
+
+//withParsedFilter wraps a ListingWithFilter resolver so the "filter" argument
+//is parsed into a FilterTree and stashed in the resolve params' context before
+//the resolver runs, so it doesn't have to parse p.Args["filter"] itself
+func withParsedFilter(resolve graphql.FieldResolveFn) graphql.FieldResolveFn {
+	return func(p graphql.ResolveParams) (interface{}, error) {
+		if raw, ok := p.Args["filter"].(map[string]interface{}); ok {
+			p.Context = context.WithValue(p.Context, filterTreeContextKey{}, ParseFilterTree(raw))
+		}
+		return resolve(p)
+	}
+}
+
+//FilterFromContext returns the FilterTree parsed from the "filter" argument of
+//the field currently being resolved, or nil if none was supplied
+func FilterFromContext(ctx context.Context) *FilterTree {
+	tree, _ := ctx.Value(filterTreeContextKey{}).(*FilterTree)
+	return tree
+}
+
+//FilterType builds the `<Entity>Filter` input object exposing per-field
+//comparison operators (derived from the filterable fields reported by
+//FieldType) plus _and/_or/_not composition
+func FilterType(name string, filters map[string]int8) *graphql.InputObject {
+	typeName := strings.Title(name) + "Filter"
+
+	var input *graphql.InputObject
+	input = graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: typeName,
+		Fields: (graphql.InputObjectConfigFieldMapThunk)(func() graphql.InputObjectConfigFieldMap {
+			fields := make(graphql.InputObjectConfigFieldMap, len(filters)+3)
+
+			for field, kind := range filters {
+				for _, op := range filterOperators(kind) {
+					fields[field+op] = &graphql.InputObjectFieldConfig{
+						Type: filterOperatorType(kind, op),
+					}
+				}
+			}
+
+			fields["_and"] = &graphql.InputObjectFieldConfig{Type: graphql.NewList(input)}
+			fields["_or"] = &graphql.InputObjectFieldConfig{Type: graphql.NewList(input)}
+			fields["_not"] = &graphql.InputObjectFieldConfig{Type: input}
+
+			return fields
+		}),
+	})
+
+	return input
+}
+
+//filterOperators lists the operator suffixes exposed for a given filter kind
+func filterOperators(kind int8) []string {
+	switch kind {
+	case filterString:
+		return append(append([]string{}, filterOperatorsCommon...), filterOperatorsString...)
+	case filterInt, filterFloat, filterDate:
+		return append(append([]string{}, filterOperatorsCommon...), filterOperatorsRange...)
+	case filterBool, filterTagged:
+		return []string{"_eq", "_ne"}
+	default:
+		return nil
+	}
+}
+
+//filterOperatorType resolves the graphql type of a given operator for a filter kind;
+//`_in` is a list of the base scalar, everything else is the scalar itself
+func filterOperatorType(kind int8, op string) graphql.Input {
+	var scalar graphql.Input
+
+	switch kind {
+	case filterString:
+		scalar = graphql.String
+	case filterBool:
+		scalar = graphql.Boolean
+	case filterInt:
+		scalar = graphql.Int
+	case filterFloat:
+		scalar = graphql.Float
+	case filterDate:
+		scalar = graphql.DateTime
+	case filterTagged:
+		//the filters map only carries a filter kind, not the registered enum/scalar
+		//type itself, so equality is compared against its GraphQL string representation
+		scalar = graphql.String
+	}
+
+	if op == "_in" {
+		return graphql.NewList(scalar)
+	}
+
+	return scalar
+}
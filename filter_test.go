@@ -0,0 +1,89 @@
+package graphql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/graphql-go/graphql"
+)
+
+func TestFilterTypeGeneratesOperatorsPerFieldKind(t *testing.T) {
+	filters := map[string]int8{
+		"title": filterString,
+		"views": filterInt,
+		"price": filterFloat,
+	}
+
+	input := FilterType("post", filters)
+	fields := input.Fields()
+
+	for _, name := range []string{"title_eq", "title_contains", "title_startsWith", "views_gt", "views_gte", "price_lt"} {
+		if _, ok := fields[name]; !ok {
+			t.Fatalf("expected generated Filter to expose %q", name)
+		}
+	}
+	if _, ok := fields["title_gt"]; ok {
+		t.Fatalf("did not expect a range operator on a string field")
+	}
+
+	for _, name := range []string{"_and", "_or", "_not"} {
+		if _, ok := fields[name]; !ok {
+			t.Fatalf("expected generated Filter to expose logical composition %q", name)
+		}
+	}
+}
+
+func TestParseFilterTreeSplitsFieldAndOperator(t *testing.T) {
+	tree := ParseFilterTree(map[string]interface{}{"title_contains": "go"})
+
+	if len(tree.And) != 1 {
+		t.Fatalf("expected a single leaf condition, got %d", len(tree.And))
+	}
+	leaf := tree.And[0]
+	if leaf.Field != "title" || leaf.Operator != "_contains" || leaf.Value != "go" {
+		t.Fatalf("expected field %q operator %q value %q, got field %q operator %q value %v", "title", "_contains", "go", leaf.Field, leaf.Operator, leaf.Value)
+	}
+}
+
+func TestParseFilterTreeResolvesLogicalComposition(t *testing.T) {
+	tree := ParseFilterTree(map[string]interface{}{
+		"_and": []interface{}{
+			map[string]interface{}{"title_eq": "go"},
+			map[string]interface{}{"views_gt": 10},
+		},
+		"_not": map[string]interface{}{"status_eq": "draft"},
+	})
+
+	if len(tree.And) != 2 {
+		t.Fatalf("expected 2 _and branches, got %d", len(tree.And))
+	}
+	if tree.Not == nil || tree.Not.And[0].Field != "status" {
+		t.Fatalf("expected a parsed _not branch")
+	}
+}
+
+func TestParseFilterTreeReturnsNilForEmptyFilter(t *testing.T) {
+	if tree := ParseFilterTree(nil); tree != nil {
+		t.Fatalf("expected a nil filter to parse to a nil tree, got %+v", tree)
+	}
+}
+
+func TestWithParsedFilterStashesTreeInContext(t *testing.T) {
+	var fromContext *FilterTree
+	resolve := withParsedFilter(func(p graphql.ResolveParams) (interface{}, error) {
+		fromContext = FilterFromContext(p.Context)
+		return nil, nil
+	})
+
+	_, err := resolve(graphql.ResolveParams{
+		Context: context.Background(),
+		Args:    map[string]interface{}{"filter": map[string]interface{}{"title_eq": "go"}},
+	})
+	if err != nil {
+		t.Fatalf("resolve returned error: %v", err)
+	}
+
+	if fromContext == nil || fromContext.And[0].Field != "title" {
+		t.Fatalf("expected the resolver to see the parsed FilterTree via FilterFromContext")
+	}
+}
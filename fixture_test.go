@@ -0,0 +1,59 @@
+package graphql
+
+import "github.com/graphql-go/graphql"
+
+//fixtureAuthor is a minimal related entity used across the package's tests
+type fixtureAuthor struct {
+	ID   string `json:"id" filterable:"true"`
+	Name string `json:"name" filterable:"true" mutable:"true" required:"true"`
+}
+
+//fixturePost is a minimal entity exercising scalars, a plain scalar slice
+//(no relationship) and a struct/slice relationship to fixtureAuthor
+type fixturePost struct {
+	ID      string        `json:"id" filterable:"true"`
+	Title   string        `json:"title" filterable:"true" mutable:"true" required:"true"`
+	Tags    []string      `json:"tags"`
+	Author  fixtureAuthor `json:"author"`
+	Authors []fixtureAuthor
+}
+
+type entityFixture struct {
+	instance    interface{}
+	description string
+}
+
+func (e entityFixture) Instance() interface{} { return e.instance }
+func (e entityFixture) Description() string   { return e.description }
+
+func newFixtureEntity(instance interface{}, description string) entityFixture {
+	return entityFixture{instance: instance, description: description}
+}
+
+//noopResolvers is a Resolvers implementation whose resolvers all return
+//nil, nil without touching any backend
+type noopResolvers struct{}
+
+func (noopResolvers) Single(entity Entity) graphql.FieldResolveFn {
+	return func(p graphql.ResolveParams) (interface{}, error) { return nil, nil }
+}
+
+func (noopResolvers) Listing(entity Entity) graphql.FieldResolveFn {
+	return func(p graphql.ResolveParams) (interface{}, error) { return nil, nil }
+}
+
+func (noopResolvers) ListingWithFilter(entity Entity) graphql.FieldResolveFn {
+	return func(p graphql.ResolveParams) (interface{}, error) { return nil, nil }
+}
+
+func (noopResolvers) Create(entity Entity) graphql.FieldResolveFn {
+	return func(p graphql.ResolveParams) (interface{}, error) { return nil, nil }
+}
+
+func (noopResolvers) Update(entity Entity) graphql.FieldResolveFn {
+	return func(p graphql.ResolveParams) (interface{}, error) { return nil, nil }
+}
+
+func (noopResolvers) Delete(entity Entity) graphql.FieldResolveFn {
+	return func(p graphql.ResolveParams) (interface{}, error) { return nil, nil }
+}
@@ -0,0 +1,170 @@
+package graphql
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/graphql-go/graphql"
+)
+
+//MutationType creates the create/update/delete mutation fields for an entity.
+//Entities with no `mutable:"true"` fields have nothing create/update could
+//meaningfully accept, so only the delete mutation is generated for them.
+//registry may be nil; it is only consulted for mutable fields tagged `graphql:"type=..."` or `graphql:"enum=..."`
+func MutationType(entity Entity, resolvers Resolvers, registry *TypeRegistry) (graphql.Fields, error) {
+	typeof := reflect.TypeOf(entity.Instance())
+	fields := make(graphql.Fields, 3)
+
+	fields["delete"+typeof.Name()] = &graphql.Field{
+		Name:        "delete" + typeof.Name(),
+		Description: fmt.Sprintf("Delete an existing %s (%s) by id", typeof.Name(), entity.Description()),
+		Type:        graphql.Boolean,
+		Args: graphql.FieldConfigArgument{
+			"id": &graphql.ArgumentConfig{
+				Type: graphql.NewNonNull(graphql.String),
+			},
+		},
+		Resolve: resolvers.Delete(entity),
+	}
+
+	input, err := inputObject(entity, registry)
+	if err == errorNoMutableFields {
+		return fields, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	fields["create"+typeof.Name()] = &graphql.Field{
+		Name:        "create" + typeof.Name(),
+		Description: fmt.Sprintf("Create a new %s (%s)", typeof.Name(), entity.Description()),
+		Type:        graphql.String,
+		Args: graphql.FieldConfigArgument{
+			"input": &graphql.ArgumentConfig{
+				Type: graphql.NewNonNull(input),
+			},
+		},
+		Resolve: resolvers.Create(entity),
+	}
+
+	fields["update"+typeof.Name()] = &graphql.Field{
+		Name:        "update" + typeof.Name(),
+		Description: fmt.Sprintf("Update an existing %s (%s) by id", typeof.Name(), entity.Description()),
+		Type:        graphql.String,
+		Args: graphql.FieldConfigArgument{
+			"id": &graphql.ArgumentConfig{
+				Type: graphql.NewNonNull(graphql.String),
+			},
+			"input": &graphql.ArgumentConfig{
+				Type: graphql.NewNonNull(input),
+			},
+		},
+		Resolve: resolvers.Update(entity),
+	}
+
+	return fields, nil
+}
+
+//inputObject builds the graphql.InputObject used as the create/update argument
+//for an entity. Returns errorNoMutableFields if the entity has no field tagged
+//`mutable:"true"`, so the caller can skip create/update generation cleanly
+//instead of handing graphql-go an empty input object it only rejects lazily.
+func inputObject(entity Entity, registry *TypeRegistry) (*graphql.InputObject, error) {
+	typeof := reflect.TypeOf(entity.Instance())
+	var numfields = typeof.NumField()
+	fields := make(graphql.InputObjectConfigFieldMap, numfields)
+
+	for i := 0; i < numfields; i++ {
+		if def, err := inputField(typeof.Field(i), registry); err == errorNotSimpleFieldType || err == errorNotMutableFieldType {
+			continue
+		} else if err != nil {
+			return nil, err
+		} else {
+			fields[def.name] = def.config
+		}
+	}
+
+	if len(fields) == 0 {
+		return nil, errorNoMutableFields
+	}
+
+	return graphql.NewInputObject(graphql.InputObjectConfig{
+		Name:   strings.ToLower(typeof.Name()) + "Input",
+		Fields: fields,
+	}), nil
+}
+
+type inputFieldDefinition struct {
+	name   string
+	config *graphql.InputObjectFieldConfig
+}
+
+//inputField creates an input field definition given a struct field, honouring the
+//`mutable` and `required` tags alongside the existing `json` naming convention.
+//A `graphql:"type=..."` or `graphql:"enum=..."` tag is resolved through registry,
+//the same as field() does for the query side, so create/update accept the
+//registered scalar/enum rather than silently downgrading to a plain string/int.
+func inputField(f reflect.StructField, registry *TypeRegistry) (inputFieldDefinition, error) {
+	var (
+		t     graphql.Input
+		def   inputFieldDefinition
+		kind  = f.Type.Kind()
+		fullt = f.Type.PkgPath() + "." + f.Type.Name()
+	)
+
+	if v, ok := f.Tag.Lookup("mutable"); !ok || !mustBool(v) {
+		return def, errorNotMutableFieldType
+	}
+
+	if tag, ok := f.Tag.Lookup("graphql"); ok {
+		if registry == nil {
+			return def, fmt.Errorf("graphql: field %s has tag %q but no TypeRegistry was supplied", f.Name, tag)
+		}
+		resolved, err := registry.resolve(tag)
+		if err != nil {
+			return def, err
+		}
+		asInput, ok := resolved.(graphql.Input)
+		if !ok {
+			return def, fmt.Errorf("graphql: field %s: %q cannot be used as a mutation input type", f.Name, tag)
+		}
+		t = asInput
+	} else if kind == reflect.String {
+		t = graphql.String
+	} else if kind == reflect.Bool {
+		t = graphql.Boolean
+	} else if kind == reflect.Int || kind == reflect.Int8 || kind == reflect.Int16 || kind == reflect.Int32 || kind == reflect.Int64 || kind == reflect.Uint || kind == reflect.Uint8 || kind == reflect.Uint16 || kind == reflect.Uint32 || kind == reflect.Uint64 {
+		t = graphql.Int
+	} else if kind == reflect.Float32 || kind == reflect.Float64 {
+		t = graphql.Float
+	} else if fullt == "time.Time" {
+		t = graphql.DateTime
+	} else {
+		return def, errorNotSimpleFieldType
+	}
+
+	if required, ok := f.Tag.Lookup("required"); ok && mustBool(required) {
+		t = graphql.NewNonNull(t)
+	}
+
+	var name string
+	if v, ok := f.Tag.Lookup("json"); ok {
+		name = v
+	} else {
+		name = f.Name
+	}
+	name = strings.ToLower(name)
+
+	def.name = name
+	def.config = &graphql.InputObjectFieldConfig{
+		Type: t,
+	}
+
+	return def, nil
+}
+
+func mustBool(v string) bool {
+	b, _ := strconv.ParseBool(v)
+	return b
+}
@@ -0,0 +1,49 @@
+package graphql
+
+import "testing"
+
+//fixtureReadOnly has no field tagged `mutable:"true"`
+type fixtureReadOnly struct {
+	ID string `json:"id"`
+}
+
+func TestMutationTypeSkipsCreateUpdateWithoutMutableFields(t *testing.T) {
+	entity := newFixtureEntity(fixtureReadOnly{}, "a read-only entity")
+
+	fields, err := MutationType(entity, noopResolvers{}, nil)
+	if err != nil {
+		t.Fatalf("MutationType returned error: %v", err)
+	}
+
+	if _, ok := fields["createfixtureReadOnly"]; ok {
+		t.Fatalf("did not expect a create mutation for an entity with no mutable fields")
+	}
+	if _, ok := fields["updatefixtureReadOnly"]; ok {
+		t.Fatalf("did not expect an update mutation for an entity with no mutable fields")
+	}
+	if _, ok := fields["deletefixtureReadOnly"]; !ok {
+		t.Fatalf("expected the delete mutation to still be generated")
+	}
+}
+
+//fixtureTaggedMutable has a mutable field tagged with a registered enum
+type fixtureTaggedMutable struct {
+	Status string `json:"status" mutable:"true" graphql:"enum=Status"`
+}
+
+func TestInputFieldResolvesRegisteredEnumInsteadOfDowngrading(t *testing.T) {
+	entity := newFixtureEntity(fixtureTaggedMutable{}, "a tagged entity")
+
+	input, err := inputObject(entity, taggedRegistry())
+	if err != nil {
+		t.Fatalf("inputObject returned error: %v", err)
+	}
+
+	field, ok := input.Fields()["status"]
+	if !ok {
+		t.Fatalf("expected a %q input field to be generated", "status")
+	}
+	if field.Type.Name() != "Status" {
+		t.Fatalf("expected the registered Status enum to be used, got %q", field.Type.Name())
+	}
+}
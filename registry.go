@@ -0,0 +1,99 @@
+package graphql
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/graphql-go/graphql"
+)
+
+//TypeRegistry holds custom scalars, enums and interfaces that field() can
+//resolve to via a `graphql:"type=Name"`, `graphql:"enum=Name"` or
+//`graphql:"interface=Name"` struct tag, for the cases FieldType can't infer
+//from the Go struct layout alone
+type TypeRegistry struct {
+	scalars    map[string]*graphql.Scalar
+	enums      map[string]*graphql.Enum
+	interfaces map[string]*graphql.Interface
+}
+
+//NewTypeRegistry creates an empty TypeRegistry
+func NewTypeRegistry() *TypeRegistry {
+	return &TypeRegistry{
+		scalars:    make(map[string]*graphql.Scalar),
+		enums:      make(map[string]*graphql.Enum),
+		interfaces: make(map[string]*graphql.Interface),
+	}
+}
+
+//RegisterScalar registers a custom scalar (bytes, big ints, UUID, JSON, ...)
+//under name, available to fields tagged `graphql:"type=name"`
+func (r *TypeRegistry) RegisterScalar(name string, serialize graphql.SerializeFn, parseValue graphql.ParseValueFn, parseLiteral graphql.ParseLiteralFn) {
+	r.scalars[name] = graphql.NewScalar(graphql.ScalarConfig{
+		Name:         name,
+		Serialize:    serialize,
+		ParseValue:   parseValue,
+		ParseLiteral: parseLiteral,
+	})
+}
+
+//RegisterEnum registers an enum under name, available to fields tagged
+//`graphql:"enum=name"`. Each value is used as both the GraphQL and internal value
+func (r *TypeRegistry) RegisterEnum(name string, values ...string) {
+	config := graphql.EnumValueConfigMap{}
+	for _, v := range values {
+		config[v] = &graphql.EnumValueConfig{Value: v}
+	}
+
+	r.enums[name] = graphql.NewEnum(graphql.EnumConfig{
+		Name:   name,
+		Values: config,
+	})
+}
+
+//RegisterInterface registers a GraphQL interface under name, used to resolve
+//struct fields that hold any of a family of concrete Entity types
+func (r *TypeRegistry) RegisterInterface(name string, resolveType graphql.ResolveTypeFn) {
+	r.interfaces[name] = graphql.NewInterface(graphql.InterfaceConfig{
+		Name:        name,
+		ResolveType: resolveType,
+	})
+}
+
+//resolve looks up the output type referenced by a `graphql:"..."` struct tag,
+//accepting `type=name` (custom scalar), `enum=name` (enum) and
+//`interface=name` (interface, for polymorphic entity fields) forms
+func (r *TypeRegistry) resolve(tag string) (graphql.Output, error) {
+	kind, name, ok := cutTag(tag)
+	if !ok {
+		return nil, fmt.Errorf("graphql: malformed tag %q, expected type=Name, enum=Name or interface=Name", tag)
+	}
+
+	switch kind {
+	case "type":
+		if scalar, ok := r.scalars[name]; ok {
+			return scalar, nil
+		}
+		return nil, fmt.Errorf("graphql: no scalar registered under %q", name)
+	case "enum":
+		if enum, ok := r.enums[name]; ok {
+			return enum, nil
+		}
+		return nil, fmt.Errorf("graphql: no enum registered under %q", name)
+	case "interface":
+		if iface, ok := r.interfaces[name]; ok {
+			return iface, nil
+		}
+		return nil, fmt.Errorf("graphql: no interface registered under %q", name)
+	default:
+		return nil, fmt.Errorf("graphql: unknown tag kind %q, expected type=, enum= or interface=", kind)
+	}
+}
+
+func cutTag(tag string) (kind, name string, ok bool) {
+	parts := strings.SplitN(tag, "=", 2)
+	if len(parts) != 2 {
+		return "", "", false
+	}
+	return parts[0], parts[1], true
+}
@@ -0,0 +1,32 @@
+package graphql
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql"
+)
+
+func TestRelationshipTypeSkipsPlainScalarSlice(t *testing.T) {
+	post := newFixtureEntity(fixturePost{}, "a blog post")
+	author := newFixtureEntity(fixtureAuthor{}, "an author")
+
+	authorObject, _, err := FieldType(author, nil)
+	if err != nil {
+		t.Fatalf("FieldType(author) returned error: %v", err)
+	}
+
+	entitiesMap := map[string]Entity{"author": author}
+	entitiesObjects := map[string]*graphql.Object{"author": authorObject}
+
+	fields, err := RelationshipType(entitiesMap, entitiesObjects, nil, post, noopResolvers{})
+	if err != nil {
+		t.Fatalf("RelationshipType returned error: %v", err)
+	}
+
+	if _, ok := fields["tag"]; ok {
+		t.Fatalf("a plain []string field must not be treated as a relationship")
+	}
+	if _, ok := fields["author"]; !ok {
+		t.Fatalf("expected the registered author relationship to be generated")
+	}
+}
@@ -15,6 +15,20 @@ var (
 	errorNotSimpleFieldType  = errors.New("not a simple field type")
 	errorNotRelationshipType = errors.New("not a relationship field type")
 	errorUnknownFieldType    = errors.New("unknown type")
+	errorNotMutableFieldType = errors.New("not a mutable field type")
+	errorNoMutableFields     = errors.New("entity has no mutable fields")
+)
+
+//Filter kind identifiers recording which comparison operators a field's
+//generated Filter input should expose
+const (
+	filterNone int8 = iota
+	filterString
+	filterBool
+	filterInt
+	filterFloat
+	filterDate
+	filterTagged
 )
 
 type fieldDefinition struct {
@@ -22,8 +36,9 @@ type fieldDefinition struct {
 	field  *graphql.Field
 }
 
-//FieldType creates a graphql type given an entity definition
-func FieldType(entity Entity) (*graphql.Object, map[string]int8, error) {
+//FieldType creates a graphql type given an entity definition. registry may be
+//nil; it is only consulted for fields tagged `graphql:"type=..."`, `graphql:"enum=..."` or `graphql:"interface=..."`
+func FieldType(entity Entity, registry *TypeRegistry) (*graphql.Object, map[string]int8, error) {
 	var fields graphql.Fields
 	typeof := reflect.TypeOf(entity.Instance())
 	var numfields = typeof.NumField()
@@ -31,7 +46,7 @@ func FieldType(entity Entity) (*graphql.Object, map[string]int8, error) {
 	filters := make(map[string]int8)
 
 	for i := 0; i < numfields; i++ {
-		if def, err := field(typeof.Field(i)); err == errorNotSimpleFieldType {
+		if def, err := field(typeof.Field(i), registry); err == errorNotSimpleFieldType {
 			continue //not a simple type
 		} else if err != nil {
 			return nil, nil, err
@@ -55,50 +70,77 @@ func FieldType(entity Entity) (*graphql.Object, map[string]int8, error) {
 	), filters, nil
 }
 
-//field creates a field definition (used by type) object given a struct field
-func field(f reflect.StructField) (fieldDefinition, error) {
+//field creates a field definition (used by type) object given a struct field.
+//registry may be nil; it is only consulted for fields carrying a `graphql` tag
+func field(f reflect.StructField, registry *TypeRegistry) (fieldDefinition, error) {
 	var (
 		t          graphql.Output
 		name       string
 		filterable bool
 		ftype      fieldDefinition
 		kind       = f.Type.Kind()
-		fulltype   = f.Type.PkgPath() + "." + f.Type.Name()
 	)
 
 	if v, ok := f.Tag.Lookup("filterable"); ok {
 		filterable, _ = strconv.ParseBool(v)
 	}
 
-	if kind == reflect.String {
-		t = graphql.String
-		if filterable {
-			ftype.filter = filterString
+	if tag, ok := f.Tag.Lookup("graphql"); ok {
+		if registry == nil {
+			return ftype, fmt.Errorf("graphql: field %s has tag %q but no TypeRegistry was supplied", f.Name, tag)
 		}
-	} else if kind == reflect.Bool {
-		t = graphql.Boolean
-		if filterable {
-			ftype.filter = filterBool
+		resolved, err := registry.resolve(tag)
+		if err != nil {
+			return ftype, err
 		}
-	} else if kind == reflect.Int || kind == reflect.Int8 || kind == reflect.Int16 || kind == reflect.Int32 || kind == reflect.Int64 || kind == reflect.Uint || kind == reflect.Uint8 || kind == reflect.Uint16 || kind == reflect.Uint32 || kind == reflect.Uint64 {
-		t = graphql.Int
-		if filterable {
-			ftype.filter = filterInt
+
+		if kind == reflect.Slice {
+			t = graphql.NewList(resolved)
+		} else {
+			t = resolved
+			if filterable {
+				ftype.filter = filterTagged
+			}
 		}
-	} else if kind == reflect.Float32 || kind == reflect.Float64 {
-		t = graphql.Float
-		if filterable {
-			ftype.filter = filterFloat
+	} else {
+		//slices and pointers are recognised as list/nullable variants of their
+		//element's scalar type, everything else is inspected directly
+		target := f.Type
+		list := kind == reflect.Slice
+		if list || kind == reflect.Ptr {
+			target = f.Type.Elem()
 		}
-	} else if fulltype == "time.Time" {
-		t = graphql.DateTime
-		if filterable {
-			ftype.filter = filterDate
+
+		tkind := target.Kind()
+		tfulltype := target.PkgPath() + "." + target.Name()
+
+		var scalar graphql.Output
+		var scalarFilter int8
+
+		if tkind == reflect.String {
+			scalar, scalarFilter = graphql.String, filterString
+		} else if tkind == reflect.Bool {
+			scalar, scalarFilter = graphql.Boolean, filterBool
+		} else if tkind == reflect.Int || tkind == reflect.Int8 || tkind == reflect.Int16 || tkind == reflect.Int32 || tkind == reflect.Int64 || tkind == reflect.Uint || tkind == reflect.Uint8 || tkind == reflect.Uint16 || tkind == reflect.Uint32 || tkind == reflect.Uint64 {
+			scalar, scalarFilter = graphql.Int, filterInt
+		} else if tkind == reflect.Float32 || tkind == reflect.Float64 {
+			scalar, scalarFilter = graphql.Float, filterFloat
+		} else if tfulltype == "time.Time" {
+			scalar, scalarFilter = graphql.DateTime, filterDate
+		} else if tkind == reflect.Struct || tkind == reflect.Slice {
+			return ftype, errorNotSimpleFieldType
+		} else {
+			return ftype, errorNotRelationshipType
+		}
+
+		if list {
+			t = graphql.NewList(scalar)
+		} else {
+			t = scalar
+			if filterable {
+				ftype.filter = scalarFilter
+			}
 		}
-	} else if kind == reflect.Struct || kind == reflect.Slice {
-		return ftype, errorNotSimpleFieldType
-	} else {
-		return ftype, errorNotRelationshipType
 	}
 
 	if v, ok := f.Tag.Lookup("json"); ok {
@@ -116,13 +158,13 @@ func field(f reflect.StructField) (fieldDefinition, error) {
 }
 
 //RelationshipType creates a graphql type given an entity definition
-func RelationshipType(entitiesMap map[string]Entity, entitiesObjects map[string]*graphql.Object, entity Entity, resolvers Resolvers) (graphql.Fields, error) {
+func RelationshipType(entitiesMap map[string]Entity, entitiesObjects map[string]*graphql.Object, entitiesFilters map[string]*graphql.InputObject, entity Entity, resolvers Resolvers) (graphql.Fields, error) {
 	typeof := reflect.TypeOf(entity.Instance())
 	var numfields = typeof.NumField()
 	var fields = make(graphql.Fields, numfields)
 
 	for i := 0; i < numfields; i++ {
-		if f, err := relationship(entitiesMap, entitiesObjects, typeof.Field(i), resolvers); err == errorNotRelationshipType {
+		if f, err := relationship(entitiesMap, entitiesObjects, entitiesFilters, typeof.Field(i), resolvers); err == errorNotRelationshipType {
 			continue //not relationship material
 		} else if err != nil {
 			return fields, err
@@ -134,11 +176,12 @@ func RelationshipType(entitiesMap map[string]Entity, entitiesObjects map[string]
 	return fields, nil
 }
 
-func relationship(entitiesMap map[string]Entity, entitiesObjects map[string]*graphql.Object, f reflect.StructField, resolvers Resolvers) (*graphql.Field, error) {
+func relationship(entitiesMap map[string]Entity, entitiesObjects map[string]*graphql.Object, entitiesFilters map[string]*graphql.InputObject, f reflect.StructField, resolvers Resolvers) (*graphql.Field, error) {
 	var (
 		typeInfo    graphql.Output
 		resolver    graphql.FieldResolveFn
 		description string
+		args        graphql.FieldConfigArgument
 	)
 
 	kind := f.Type.Kind()
@@ -147,7 +190,14 @@ func relationship(entitiesMap map[string]Entity, entitiesObjects map[string]*gra
 
 	if kind == reflect.Slice {
 		name = strings.ToLower(inflection.Singular(name))
-	} else if fulltype == "time.Time" || (kind != reflect.Struct && kind != reflect.Slice) {
+		//a plain slice-of-scalar (e.g. []string) isn't relationship material;
+		//field() already claims those, so just skip them here
+		if _, ok := entitiesMap[name]; !ok {
+			return nil, errorNotRelationshipType
+		} else if _, ok := entitiesObjects[name]; !ok {
+			return nil, errorNotRelationshipType
+		}
+	} else if fulltype == "time.Time" || kind != reflect.Struct {
 		return nil, errorNotRelationshipType
 	} else if _, ok := entitiesMap[name]; !ok {
 		return nil, errorUnknownFieldType
@@ -164,7 +214,14 @@ func relationship(entitiesMap map[string]Entity, entitiesObjects map[string]*gra
 	} else if kind == reflect.Slice {
 		typeInfo = graphql.NewList(entitiesObjects[name])
 		description = fmt.Sprintf("Get a list of %s (%s) according to filters", typeInfo.Name(), entity.Description())
-		resolver = resolvers.Listing(entity)
+		if filter, ok := entitiesFilters[name]; ok {
+			args = graphql.FieldConfigArgument{
+				"filter": &graphql.ArgumentConfig{Type: filter},
+			}
+			resolver = withParsedFilter(resolvers.ListingWithFilter(entity))
+		} else {
+			resolver = resolvers.Listing(entity)
+		}
 	} else {
 		return nil, errorNotRelationshipType
 	}
@@ -173,6 +230,7 @@ func relationship(entitiesMap map[string]Entity, entitiesObjects map[string]*gra
 		Name:        name,
 		Type:        typeInfo,
 		Description: description,
+		Args:        args,
 		Resolve:     resolver,
 	}, nil
 }